@@ -0,0 +1,6 @@
+package account
+
+// Account is a registered RuneHistory account.
+type Account struct {
+	ID string
+}
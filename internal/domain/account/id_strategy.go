@@ -0,0 +1,111 @@
+package account
+
+import (
+	"crypto/rand"
+	"fmt"
+	"os"
+
+	"github.com/google/uuid"
+	"github.com/oklog/ulid/v2"
+)
+
+// Env var read by IDStrategyFromEnv to select the IDStrategy an operator
+// wants new accounts to be created with.
+const idStrategyEnvVar = "RUNEHISTORY_ID_STRATEGY"
+
+// Names accepted by NewIDStrategy and idStrategyEnvVar.
+const (
+	StrategyUUIDv4 = "uuidv4"
+	StrategyUUIDv7 = "uuidv7"
+	StrategyULID   = "ulid"
+)
+
+// DefaultIDStrategy is used when idStrategyEnvVar is unset.
+const DefaultIDStrategy = StrategyUUIDv7
+
+// IDStrategy generates new account IDs and validates that an existing ID
+// was produced by it.
+type IDStrategy interface {
+	Generate() (string, error)
+	Parse(id string) error
+}
+
+// NewIDStrategy returns the IDStrategy registered under name.
+func NewIDStrategy(name string) (IDStrategy, error) {
+	switch name {
+	case StrategyUUIDv4:
+		return uuidV4Strategy{}, nil
+	case StrategyUUIDv7:
+		return uuidV7Strategy{}, nil
+	case StrategyULID:
+		return ulidStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown id strategy %q", name)
+	}
+}
+
+// IDStrategyFromEnv resolves the configured IDStrategy from
+// RUNEHISTORY_ID_STRATEGY, falling back to DefaultIDStrategy when unset.
+// UUIDv7 and ULID are both time-ordered, which keeps Hiscore table inserts
+// index-local; operators can opt back into UUIDv4 if they need it.
+func IDStrategyFromEnv() (IDStrategy, error) {
+	name := os.Getenv(idStrategyEnvVar)
+	if name == "" {
+		name = DefaultIDStrategy
+	}
+	return NewIDStrategy(name)
+}
+
+type uuidV4Strategy struct{}
+
+func (uuidV4Strategy) Generate() (string, error) {
+	return uuid.New().String(), nil
+}
+
+func (uuidV4Strategy) Parse(id string) error {
+	return parseUUIDVersion(id, 4)
+}
+
+type uuidV7Strategy struct{}
+
+func (uuidV7Strategy) Generate() (string, error) {
+	id, err := uuid.NewV7()
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (uuidV7Strategy) Parse(id string) error {
+	return parseUUIDVersion(id, 7)
+}
+
+// parseUUIDVersion parses id as a UUID and checks it's of the given
+// version, so a uuidv4 strategy rejects a uuidv7 ID and vice versa rather
+// than accepting any RFC4122 UUID.
+func parseUUIDVersion(id string, version uuid.Version) error {
+	parsed, err := uuid.Parse(id)
+	if err != nil {
+		return err
+	}
+	if parsed.Version() != version {
+		return fmt.Errorf("id %q is a version %d UUID, want version %d", id, parsed.Version(), version)
+	}
+	return nil
+}
+
+type ulidStrategy struct{}
+
+func (ulidStrategy) Generate() (string, error) {
+	id, err := ulid.New(ulid.Now(), rand.Reader)
+	if err != nil {
+		return "", err
+	}
+	return id.String(), nil
+}
+
+func (ulidStrategy) Parse(id string) error {
+	_, err := ulid.ParseStrict(id)
+	return err
+}
+
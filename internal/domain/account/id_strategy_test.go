@@ -0,0 +1,68 @@
+package account
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIDStrategy_Unknown(t *testing.T) {
+	a := assert.New(t)
+
+	_, err := NewIDStrategy("not-a-strategy")
+	a.Error(err)
+}
+
+func TestIDStrategies_GenerateAndParse(t *testing.T) {
+	for _, name := range []string{StrategyUUIDv4, StrategyUUIDv7, StrategyULID} {
+		name := name
+		t.Run(name, func(t *testing.T) {
+			a := assert.New(t)
+
+			strategy, err := NewIDStrategy(name)
+			a.NoError(err)
+
+			id, err := strategy.Generate()
+			a.NoError(err)
+			a.NotEmpty(id)
+			a.NoError(strategy.Parse(id), "generated id should parse under its own strategy")
+
+			a.Error(strategy.Parse("not-a-real-id"))
+		})
+	}
+}
+
+func TestUUIDStrategies_RejectEachOthersIDs(t *testing.T) {
+	a := assert.New(t)
+
+	v4, err := NewIDStrategy(StrategyUUIDv4)
+	a.NoError(err)
+	v7, err := NewIDStrategy(StrategyUUIDv7)
+	a.NoError(err)
+
+	v4ID, err := v4.Generate()
+	a.NoError(err)
+	v7ID, err := v7.Generate()
+	a.NoError(err)
+
+	a.Error(v7.Parse(v4ID), "uuidv7 strategy should reject a uuidv4 id")
+	a.Error(v4.Parse(v7ID), "uuidv4 strategy should reject a uuidv7 id")
+}
+
+func TestIDStrategyFromEnv_Default(t *testing.T) {
+	a := assert.New(t)
+
+	t.Setenv(idStrategyEnvVar, "")
+	strategy, err := IDStrategyFromEnv()
+	a.NoError(err)
+	a.Equal(uuidV7Strategy{}, strategy)
+}
+
+func TestIDStrategyFromEnv_Configured(t *testing.T) {
+	a := assert.New(t)
+
+	t.Setenv(idStrategyEnvVar, StrategyULID)
+	strategy, err := IDStrategyFromEnv()
+	a.NoError(err)
+	a.Equal(ulidStrategy{}, strategy)
+}
@@ -0,0 +1,25 @@
+package account
+
+import "github.com/stretchr/testify/mock"
+
+// MockRepository is a testify/mock Repository double for use in unit tests.
+type MockRepository struct {
+	mock.Mock
+}
+
+func (m *MockRepository) CountId(id string) (int, error) {
+	args := m.Called(id)
+	return args.Int(0), args.Error(1)
+}
+
+func (m *MockRepository) CountIds(ids []string) (map[string]int, error) {
+	args := m.Called(ids)
+	counts, _ := args.Get(0).(map[string]int)
+	return counts, args.Error(1)
+}
+
+func (m *MockRepository) GetById(id string) (*Account, error) {
+	args := m.Called(id)
+	acc, _ := args.Get(0).(*Account)
+	return acc, args.Error(1)
+}
@@ -0,0 +1,101 @@
+package account
+
+import (
+	"sync"
+	"testing"
+	"time"
+)
+
+// Call is a single recorded invocation of a RecordingRepository method.
+type Call struct {
+	Method    string
+	Args      []interface{}
+	Timestamp time.Time
+}
+
+// RecordingRepository is a Repository test double that records every
+// invocation, in order, so tests can assert on call sequences and
+// arguments rather than only on the last expectation set up.
+type RecordingRepository struct {
+	// CountIdFunc, when set, supplies the return value of CountId.
+	CountIdFunc func(id string) (int, error)
+	// CountIdsFunc, when set, supplies the return value of CountIds.
+	CountIdsFunc func(ids []string) (map[string]int, error)
+	// GetByIdFunc, when set, supplies the return value of GetById.
+	GetByIdFunc func(id string) (*Account, error)
+
+	mu    sync.Mutex
+	calls []Call
+}
+
+func (r *RecordingRepository) record(method string, args ...interface{}) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, Call{Method: method, Args: args, Timestamp: time.Now()})
+}
+
+func (r *RecordingRepository) CountId(id string) (int, error) {
+	r.record("CountId", id)
+	if r.CountIdFunc != nil {
+		return r.CountIdFunc(id)
+	}
+	return 0, nil
+}
+
+func (r *RecordingRepository) CountIds(ids []string) (map[string]int, error) {
+	r.record("CountIds", ids)
+	if r.CountIdsFunc != nil {
+		return r.CountIdsFunc(ids)
+	}
+	return nil, nil
+}
+
+func (r *RecordingRepository) GetById(id string) (*Account, error) {
+	r.record("GetById", id)
+	if r.GetByIdFunc != nil {
+		return r.GetByIdFunc(id)
+	}
+	return nil, nil
+}
+
+// Calls returns every call recorded so far, in invocation order.
+func (r *RecordingRepository) Calls() []Call {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Call(nil), r.calls...)
+}
+
+// CallsTo returns the recorded calls to method, in invocation order.
+func (r *RecordingRepository) CallsTo(method string) []Call {
+	var calls []Call
+	for _, c := range r.Calls() {
+		if c.Method == method {
+			calls = append(calls, c)
+		}
+	}
+	return calls
+}
+
+// AssertCallOrder fails the test unless the recorded calls' method names
+// match want exactly, in order.
+func (r *RecordingRepository) AssertCallOrder(t *testing.T, want ...string) bool {
+	t.Helper()
+
+	calls := r.Calls()
+	got := make([]string, len(calls))
+	for i, c := range calls {
+		got[i] = c.Method
+	}
+
+	if len(got) != len(want) {
+		t.Errorf("AssertCallOrder: got %d calls %v, want %d calls %v", len(got), got, len(want), want)
+		return false
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("AssertCallOrder: call %d was %q, want %q (got %v, want %v)", i, got[i], want[i], got, want)
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,65 @@
+package account
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecordingRepository_Calls(t *testing.T) {
+	a := assert.New(t)
+	repo := &RecordingRepository{}
+
+	_, _ = repo.CountId("a")
+	_, _ = repo.GetById("b")
+	_, _ = repo.CountId("c")
+
+	calls := repo.Calls()
+	a.Len(calls, 3)
+	a.Equal("CountId", calls[0].Method)
+	a.Equal([]interface{}{"a"}, calls[0].Args)
+	a.Equal("GetById", calls[1].Method)
+	a.Equal("CountId", calls[2].Method)
+}
+
+func TestRecordingRepository_CallsTo(t *testing.T) {
+	a := assert.New(t)
+	repo := &RecordingRepository{}
+
+	_, _ = repo.CountId("a")
+	_, _ = repo.GetById("b")
+	_, _ = repo.CountId("c")
+
+	countCalls := repo.CallsTo("CountId")
+	a.Len(countCalls, 2)
+	a.Equal("a", countCalls[0].Args[0])
+	a.Equal("c", countCalls[1].Args[0])
+
+	a.Empty(repo.CallsTo("DeleteById"))
+}
+
+func TestRecordingRepository_AssertCallOrder(t *testing.T) {
+	a := assert.New(t)
+	repo := &RecordingRepository{}
+
+	_, _ = repo.CountId("a")
+	_, _ = repo.GetById("b")
+
+	a.True(repo.AssertCallOrder(t, "CountId", "GetById"))
+}
+
+func TestRecordingRepository_FuncOverrides(t *testing.T) {
+	a := assert.New(t)
+	wantErr := errors.New("boom")
+	repo := &RecordingRepository{
+		CountIdFunc: func(id string) (int, error) {
+			return 42, wantErr
+		},
+	}
+
+	count, err := repo.CountId("whatever")
+	a.Equal(42, count)
+	a.Equal(wantErr, err)
+}
+
@@ -0,0 +1,12 @@
+package account
+
+// Repository is the persistence boundary for accounts.
+type Repository interface {
+	// CountId returns how many accounts currently exist with the given ID.
+	CountId(id string) (int, error)
+	// CountIds is the batched form of CountId, returning a count per ID in
+	// a single round-trip. IDs absent from the result have a count of 0.
+	CountIds(ids []string) (map[string]int, error)
+	// GetById returns the account with the given ID.
+	GetById(id string) (*Account, error)
+}
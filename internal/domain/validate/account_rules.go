@@ -0,0 +1,147 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/runehistory/runehistory-api/internal/domain/account"
+)
+
+// AccountRuleSet is the RuleSet used to validate an account before it is
+// persisted.
+type AccountRuleSet struct {
+	*RuleSet[*account.Account]
+}
+
+// NewAccountRuleSet composes the standard account rules, backed by repo for
+// the rules that need to consult existing data and strategy for the rule
+// that validates ID format. It uses IDWillBeUnique, since the account has
+// not been persisted yet.
+func NewAccountRuleSet(repo account.Repository, strategy account.IDStrategy) *AccountRuleSet {
+	return &AccountRuleSet{
+		RuleSet: NewRuleSet[*account.Account](
+			ModeAccumulate,
+			&IDIsPresent{},
+			&IDIsValidFormat{Strategy: strategy},
+			&IDWillBeUnique{Repo: repo},
+		),
+	}
+}
+
+// NewAccountRuleSetFromCounts composes the account rules used by
+// BatchValidator, where counts has already been populated via a single
+// Repository.CountIds call instead of one CountId call per account.
+func NewAccountRuleSetFromCounts(strategy account.IDStrategy, counts map[string]int) *AccountRuleSet {
+	return &AccountRuleSet{
+		RuleSet: NewRuleSet[*account.Account](
+			ModeAccumulate,
+			&IDIsPresent{},
+			&IDIsValidFormat{Strategy: strategy},
+			&IDIsUniqueInBatch{Counts: counts},
+		),
+	}
+}
+
+// StdAccountRules builds the standard AccountRuleSet for a given repository
+// and IDStrategy.
+type StdAccountRules struct {
+	AccountRepo account.Repository
+	IDStrategy  account.IDStrategy
+}
+
+// RuleSet returns the AccountRuleSet backed by AccountRepo and IDStrategy.
+func (r *StdAccountRules) RuleSet() *AccountRuleSet {
+	return NewAccountRuleSet(r.AccountRepo, r.IDStrategy)
+}
+
+// IDIsPresent fails when an account has no ID set.
+type IDIsPresent struct{}
+
+func (r *IDIsPresent) Name() string       { return "IDIsPresent" }
+func (r *IDIsPresent) Code() string       { return "account.id.present" }
+func (r *IDIsPresent) Severity() Severity { return SeverityError }
+
+func (r *IDIsPresent) Validate(acc *account.Account) error {
+	if acc.ID == "" {
+		return fmt.Errorf("id must be present")
+	}
+	return nil
+}
+
+// IDIsValidFormat fails when an account ID doesn't parse under Strategy,
+// the IDStrategy configured for the running instance.
+type IDIsValidFormat struct {
+	Strategy account.IDStrategy
+}
+
+func (r *IDIsValidFormat) Name() string       { return "IDIsValidFormat" }
+func (r *IDIsValidFormat) Code() string       { return "account.id.format" }
+func (r *IDIsValidFormat) Severity() Severity { return SeverityError }
+
+func (r *IDIsValidFormat) Validate(acc *account.Account) error {
+	if err := r.Strategy.Parse(acc.ID); err != nil {
+		return fmt.Errorf("id %q is not a valid %T: %w", acc.ID, r.Strategy, err)
+	}
+	return nil
+}
+
+// IDIsUnique fails when more than one account already exists with this ID.
+// It is intended for validating an account that may already be persisted
+// (e.g. on update), where the account itself accounts for one match.
+type IDIsUnique struct {
+	Repo account.Repository
+}
+
+func (r *IDIsUnique) Name() string       { return "IDIsUnique" }
+func (r *IDIsUnique) Code() string       { return "account.id.unique" }
+func (r *IDIsUnique) Severity() Severity { return SeverityError }
+
+func (r *IDIsUnique) Validate(acc *account.Account) error {
+	count, err := r.Repo.CountId(acc.ID)
+	if err != nil {
+		return err
+	}
+	if count > 1 {
+		return fmt.Errorf("id %q is not unique", acc.ID)
+	}
+	return nil
+}
+
+// IDIsUniqueInBatch fails when Counts, a precomputed account.Repository
+// CountIds result, shows an existing account with this ID. It is the
+// batch equivalent of IDWillBeUnique, avoiding a repository call per
+// account when validating new accounts before a bulk insert.
+type IDIsUniqueInBatch struct {
+	Counts map[string]int
+}
+
+func (r *IDIsUniqueInBatch) Name() string       { return "IDIsUniqueInBatch" }
+func (r *IDIsUniqueInBatch) Code() string       { return "account.id.will_be_unique" }
+func (r *IDIsUniqueInBatch) Severity() Severity { return SeverityError }
+
+func (r *IDIsUniqueInBatch) Validate(acc *account.Account) error {
+	if r.Counts[acc.ID] >= 1 {
+		return fmt.Errorf("id %q already exists", acc.ID)
+	}
+	return nil
+}
+
+// IDWillBeUnique fails when an account with this ID already exists. It is
+// intended for validating a new account before it is first persisted.
+type IDWillBeUnique struct {
+	Repo account.Repository
+}
+
+func (r *IDWillBeUnique) Name() string       { return "IDWillBeUnique" }
+func (r *IDWillBeUnique) Code() string       { return "account.id.will_be_unique" }
+func (r *IDWillBeUnique) Severity() Severity { return SeverityError }
+
+func (r *IDWillBeUnique) Validate(acc *account.Account) error {
+	count, err := r.Repo.CountId(acc.ID)
+	if err != nil {
+		return err
+	}
+	if count >= 1 {
+		return fmt.Errorf("id %q already exists", acc.ID)
+	}
+	return nil
+}
@@ -7,146 +7,189 @@ import (
 	"testing"
 )
 
-func TestStdAccountRules_IDIsPresent(t *testing.T) {
+func TestIDIsPresent(t *testing.T) {
 	a := assert.New(t)
-	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDIsPresent{}
 
 	acc := &account.Account{
 		ID: "present-id",
 	}
-	err := rules.IDIsPresent(acc)
+	err := rule.Validate(acc)
 	a.Nil(err, "not expecting err for present ID")
 
 	acc = &account.Account{
 		ID: "",
 	}
-	err = rules.IDIsPresent(acc)
+	err = rule.Validate(acc)
 	a.NotNil(err, "expecting error for vacant ID")
 }
 
-func TestStdAccountRules_IDIsCorrectLength(t *testing.T) {
+func TestIDIsValidFormat(t *testing.T) {
 	a := assert.New(t)
-	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
 
-	acc := &account.Account{
-		ID: "uuid-correct-length-1234567890123456",
-	}
-	err := rules.IDIsCorrectLength(acc)
-	a.Nilf(err, "id with length %d should be valid", len(acc.ID))
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+	rule := &IDIsValidFormat{Strategy: strategy}
 
-	acc = &account.Account{
-		ID: "uuid-incorrect-length",
-	}
-	err = rules.IDIsCorrectLength(acc)
-	a.NotNilf(err, "id with length %d should be invalid", len(acc.ID))
+	id, err := strategy.Generate()
+	a.NoError(err)
+	acc := &account.Account{ID: id}
+	a.Nilf(rule.Validate(acc), "id %q generated by the strategy should be valid", id)
+
+	acc = &account.Account{ID: "not-a-real-id"}
+	a.NotNil(rule.Validate(acc), "malformed id should be invalid")
 }
 
-func TestStdAccountRules_IDIsUnique_UniqueID(t *testing.T) {
+func TestIDIsUnique_UniqueID(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDIsUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "unique-id",
 	}
 	repo.On("CountId", acc.ID).Return(1, nil).Once()
-	err := rules.IDIsUnique(acc)
+	err := rule.Validate(acc)
 	a.Nil(err, "expecting ID to be unique", acc.ID)
 	repo.AssertExpectations(t)
 }
 
-func TestStdAccountRules_IDIsUnique_NonUniqueID(t *testing.T) {
+func TestIDIsUnique_NonUniqueID(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDIsUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "non-unique-id",
 	}
 	repo.On("CountId", acc.ID).Return(2, nil).Once()
-	err := rules.IDIsUnique(acc)
+	err := rule.Validate(acc)
 	a.NotNil(err, "expecting duplicate ID", acc.ID)
 	repo.AssertExpectations(t)
 }
 
-func TestStdAccountRules_IDIsUnique_Err(t *testing.T) {
+func TestIDIsUnique_Err(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDIsUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "id-is-unique-err",
 	}
 	repo.On("CountId", acc.ID).Return(0, errors.New("expecting failure")).Once()
-	err := rules.IDIsUnique(acc)
+	err := rule.Validate(acc)
 	a.NotNil(err, "expecting error")
 	a.EqualError(err, "expecting failure")
 	repo.AssertExpectations(t)
 }
 
-func TestStdAccountRules_IDWillBeUnique_Unique(t *testing.T) {
+func TestIDWillBeUnique_Unique(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDWillBeUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "will-be-unique-id",
 	}
-	repo.On("CountId", acc.ID).Return(1, nil).Once()
-	err := rules.IDIsUnique(acc)
+	repo.On("CountId", acc.ID).Return(0, nil).Once()
+	err := rule.Validate(acc)
 	a.Nil(err, "expecting id to be unique")
 	repo.AssertExpectations(t)
 }
 
-func TestStdAccountRules_IDWillBeUnique_NonUnique(t *testing.T) {
+func TestIDWillBeUnique_NonUnique(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDWillBeUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "non-unique-id",
 	}
 	repo.On("CountId", acc.ID).Return(1, nil).Once()
-	err := rules.IDWillBeUnique(acc)
+	err := rule.Validate(acc)
 	a.NotNilf(err, "expecting duplicate id: %s", acc.ID)
 	repo.AssertExpectations(t)
 }
 
-func TestStdAccountRules_IDWillBeUnique_Error(t *testing.T) {
+func TestIDWillBeUnique_Error(t *testing.T) {
 	a := assert.New(t)
 
 	repo := new(account.MockRepository)
-	rules := &StdAccountRules{
-		AccountRepo: repo,
-	}
+	rule := &IDWillBeUnique{Repo: repo}
 
 	acc := &account.Account{
 		ID: "id-is-unique-err",
 	}
 	repo.On("CountId", acc.ID).Return(0, errors.New("expecting failure")).Once()
-	err := rules.IDWillBeUnique(acc)
+	err := rule.Validate(acc)
 	a.NotNil(err, "expecting error")
 	a.EqualError(err, "expecting failure")
 	repo.AssertExpectations(t)
-}
\ No newline at end of file
+}
+
+func TestIDWillBeUnique_CallsCountIdOnceAndNeverGetById(t *testing.T) {
+	a := assert.New(t)
+
+	repo := &account.RecordingRepository{
+		CountIdFunc: func(id string) (int, error) {
+			return 0, nil
+		},
+	}
+	rule := &IDWillBeUnique{Repo: repo}
+
+	acc := &account.Account{
+		ID: "will-be-unique-id",
+	}
+	a.Nil(rule.Validate(acc))
+	a.True(repo.AssertCallOrder(t, "CountId"))
+	a.Equal(acc.ID, repo.CallsTo("CountId")[0].Args[0])
+	a.Empty(repo.CallsTo("GetById"))
+}
+
+func TestStdAccountRules_RuleSet(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+	repo := new(account.MockRepository)
+	rules := &StdAccountRules{
+		AccountRepo: repo,
+		IDStrategy:  strategy,
+	}
+
+	id, err := strategy.Generate()
+	a.NoError(err)
+	acc := &account.Account{ID: id}
+	repo.On("CountId", acc.ID).Return(0, nil).Once()
+
+	verr := rules.RuleSet().Validate(acc)
+	a.Nil(verr, "expecting no validation errors for a valid account")
+	repo.AssertExpectations(t)
+}
+
+func TestStdAccountRules_RuleSet_AccumulatesErrors(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+	repo := new(account.MockRepository)
+	rules := &StdAccountRules{
+		AccountRepo: repo,
+		IDStrategy:  strategy,
+	}
+
+	acc := &account.Account{
+		ID: "",
+	}
+	repo.On("CountId", acc.ID).Return(0, nil).Once()
+
+	verr := rules.RuleSet().Validate(acc)
+	a.NotNil(verr, "expecting validation errors")
+	a.True(verr.HasErrors())
+	a.Len(verr.Errors, 2, "expecting IDIsPresent and IDIsValidFormat to both fail")
+}
@@ -0,0 +1,115 @@
+package validate
+
+import (
+	"context"
+	"sync"
+
+	"github.com/runehistory/runehistory-api/internal/domain/account"
+)
+
+// DefaultBatchWorkers is used when BatchValidator.Workers is unset.
+const DefaultBatchWorkers = 8
+
+// BatchResult is the outcome of a BatchValidator run. Errors is indexed
+// the same way as the []*account.Account passed to Validate: Errors[i] is
+// the ValidationError for accounts[i], or nil if it was valid or was never
+// validated because ctx was done before it was dispatched.
+type BatchResult struct {
+	Errors []*ValidationError
+}
+
+// BatchValidator validates many accounts concurrently, batching the
+// repository lookups a RuleSet would otherwise make once per account.
+// This turns a bulk import's validation pass from one round-trip per
+// account into a single CountIds call.
+type BatchValidator struct {
+	Repo     account.Repository
+	Strategy account.IDStrategy
+	// Workers is the number of goroutines validating accounts concurrently.
+	// Defaults to DefaultBatchWorkers when <= 0.
+	Workers int
+}
+
+// NewBatchValidator builds a BatchValidator backed by repo and strategy,
+// using DefaultBatchWorkers.
+func NewBatchValidator(repo account.Repository, strategy account.IDStrategy) *BatchValidator {
+	return &BatchValidator{Repo: repo, Strategy: strategy, Workers: DefaultBatchWorkers}
+}
+
+// Validate validates every account in accounts concurrently, returning a
+// BatchResult with the same ordering as accounts regardless of which
+// worker finishes first. It stops dispatching new work once ctx is done,
+// in which case it returns the partial result alongside ctx.Err().
+func (b *BatchValidator) Validate(ctx context.Context, accounts []*account.Account) (*BatchResult, error) {
+	ids := make([]string, len(accounts))
+	for i, acc := range accounts {
+		ids[i] = acc.ID
+	}
+	counts, err := b.Repo.CountIds(ids)
+	if err != nil {
+		return nil, err
+	}
+	counts = addInBatchDuplicates(counts, ids)
+
+	result := &BatchResult{Errors: make([]*ValidationError, len(accounts))}
+	ruleSet := NewAccountRuleSetFromCounts(b.Strategy, counts)
+
+	workers := b.Workers
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	if workers > len(accounts) {
+		workers = len(accounts)
+	}
+
+	indexes := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range indexes {
+				result.Errors[i] = ruleSet.Validate(accounts[i])
+			}
+		}()
+	}
+
+dispatch:
+	for i := range accounts {
+		select {
+		case indexes <- i:
+		case <-ctx.Done():
+			break dispatch
+		}
+	}
+	close(indexes)
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return result, err
+	}
+	return result, nil
+}
+
+// addInBatchDuplicates folds occurrences of each ID within ids into counts,
+// so two accounts sharing an ID that doesn't exist in the repository yet
+// are still flagged as colliding with each other. An ID's own occurrence
+// accounts for one match, matching IDIsUnique/IDWillBeUnique's repository
+// semantics.
+func addInBatchDuplicates(counts map[string]int, ids []string) map[string]int {
+	occurrences := make(map[string]int, len(ids))
+	for _, id := range ids {
+		occurrences[id]++
+	}
+
+	merged := make(map[string]int, len(occurrences))
+	for id, count := range counts {
+		merged[id] = count
+	}
+	for id, occurs := range occurrences {
+		if occurs > 1 {
+			merged[id] += occurs - 1
+		}
+	}
+	return merged
+}
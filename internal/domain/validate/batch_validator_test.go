@@ -0,0 +1,114 @@
+package validate
+
+import (
+	"context"
+	"testing"
+
+	"github.com/runehistory/runehistory-api/internal/domain/account"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBatchValidator_Validate(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+
+	validID, err := strategy.Generate()
+	a.NoError(err)
+	dupeID, err := strategy.Generate()
+	a.NoError(err)
+
+	accounts := []*account.Account{
+		{ID: validID},
+		{ID: ""},
+		{ID: dupeID},
+	}
+
+	repo := &account.RecordingRepository{
+		CountIdsFunc: func(ids []string) (map[string]int, error) {
+			return map[string]int{dupeID: 2}, nil
+		},
+	}
+	bv := &BatchValidator{Repo: repo, Strategy: strategy, Workers: 2}
+
+	result, err := bv.Validate(context.Background(), accounts)
+	a.NoError(err)
+	a.Len(result.Errors, 3)
+	a.Nil(result.Errors[0], "valid, non-duplicate account should pass")
+	a.NotNil(result.Errors[1], "empty id should fail IDIsPresent")
+	a.NotNil(result.Errors[2], "duplicated id should fail IDIsUniqueInBatch")
+
+	a.Len(repo.CallsTo("CountIds"), 1, "expecting a single batched lookup, not one per account")
+	a.Empty(repo.CallsTo("CountId"), "batch validation must not fall back to per-account lookups")
+}
+
+func TestBatchValidator_Validate_DuplicateWithinBatch(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+
+	sharedID, err := strategy.Generate()
+	a.NoError(err)
+
+	accounts := []*account.Account{
+		{ID: sharedID},
+		{ID: sharedID},
+	}
+
+	repo := &account.RecordingRepository{
+		CountIdsFunc: func(ids []string) (map[string]int, error) {
+			// Neither account exists yet, so the repository alone sees no
+			// collision; the duplicate is only visible within the batch.
+			return map[string]int{}, nil
+		},
+	}
+	bv := &BatchValidator{Repo: repo, Strategy: strategy, Workers: 2}
+
+	result, err := bv.Validate(context.Background(), accounts)
+	a.NoError(err)
+	a.NotNil(result.Errors[0], "first copy of the shared id should fail")
+	a.NotNil(result.Errors[1], "second copy of the shared id should fail")
+}
+
+func TestBatchValidator_Validate_CountIdsError(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+
+	repo := new(account.MockRepository)
+	repo.On("CountIds", []string{"a"}).Return(map[string]int(nil), assert.AnError).Once()
+	bv := &BatchValidator{Repo: repo, Strategy: strategy}
+
+	result, err := bv.Validate(context.Background(), []*account.Account{{ID: "a"}})
+	a.Nil(result)
+	a.ErrorIs(err, assert.AnError)
+}
+
+func TestBatchValidator_Validate_ContextCancelled(t *testing.T) {
+	a := assert.New(t)
+
+	strategy, err := account.NewIDStrategy(account.StrategyUUIDv7)
+	a.NoError(err)
+
+	repo := &account.RecordingRepository{
+		CountIdsFunc: func(ids []string) (map[string]int, error) {
+			return map[string]int{}, nil
+		},
+	}
+	bv := &BatchValidator{Repo: repo, Strategy: strategy, Workers: 1}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	accounts := make([]*account.Account, 100)
+	for i := range accounts {
+		accounts[i] = &account.Account{ID: ""}
+	}
+
+	result, err := bv.Validate(ctx, accounts)
+	a.ErrorIs(err, context.Canceled)
+	a.Len(result.Errors, len(accounts), "result slice keeps input ordering even on early cancellation")
+}
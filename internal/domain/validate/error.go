@@ -0,0 +1,40 @@
+package validate
+
+import "fmt"
+
+// FieldError is a single rule violation.
+type FieldError struct {
+	Rule     string
+	Code     string
+	Message  string
+	Severity Severity
+}
+
+// ValidationError aggregates the FieldErrors produced by a RuleSet, so
+// callers (e.g. HTTP handlers) can return a structured response instead of
+// a single error string.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	if e == nil || len(e.Errors) == 0 {
+		return "validation failed"
+	}
+	return fmt.Sprintf("%s: %s", e.Errors[0].Rule, e.Errors[0].Message)
+}
+
+// Add appends a FieldError describing a single rule violation.
+func (e *ValidationError) Add(rule, code string, severity Severity, err error) {
+	e.Errors = append(e.Errors, FieldError{
+		Rule:     rule,
+		Code:     code,
+		Message:  err.Error(),
+		Severity: severity,
+	})
+}
+
+// HasErrors reports whether any FieldError was recorded.
+func (e *ValidationError) HasErrors() bool {
+	return e != nil && len(e.Errors) > 0
+}
@@ -0,0 +1,46 @@
+package validate
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidationError_Error_Nil(t *testing.T) {
+	a := assert.New(t)
+
+	var verr *ValidationError
+	var err error = verr
+	a.NotPanics(func() {
+		a.Equal("validation failed", err.Error())
+	})
+}
+
+func TestValidationError_Error_Empty(t *testing.T) {
+	a := assert.New(t)
+
+	verr := &ValidationError{}
+	a.Equal("validation failed", verr.Error())
+}
+
+func TestValidationError_Error_WithErrors(t *testing.T) {
+	a := assert.New(t)
+
+	verr := &ValidationError{}
+	verr.Add("IDIsPresent", "account.id.present", SeverityError, errors.New("id must be present"))
+	a.Equal("IDIsPresent: id must be present", verr.Error())
+}
+
+func TestValidationError_HasErrors(t *testing.T) {
+	a := assert.New(t)
+
+	var verr *ValidationError
+	a.False(verr.HasErrors())
+
+	verr = &ValidationError{}
+	a.False(verr.HasErrors())
+
+	verr.Add("IDIsPresent", "account.id.present", SeverityError, errors.New("id must be present"))
+	a.True(verr.HasErrors())
+}
@@ -0,0 +1,24 @@
+package validate
+
+// Severity indicates how a rule violation should be treated by callers.
+type Severity int
+
+const (
+	// SeverityError means the subject must be rejected.
+	SeverityError Severity = iota
+	// SeverityWarning means the subject may proceed but the violation
+	// should be surfaced to the caller.
+	SeverityWarning
+)
+
+// Rule is a single, named validation check against a T.
+type Rule[T any] interface {
+	// Name identifies the rule, e.g. in logs and FieldError.Rule.
+	Name() string
+	// Code is a stable, machine-readable identifier for the violation,
+	// suitable for API responses.
+	Code() string
+	Severity() Severity
+	// Validate returns a non-nil error when v violates the rule.
+	Validate(v T) error
+}
@@ -0,0 +1,41 @@
+package validate
+
+// Mode controls how a RuleSet reacts to a failing rule.
+type Mode int
+
+const (
+	// ModeShortCircuit stops at the first failing rule.
+	ModeShortCircuit Mode = iota
+	// ModeAccumulate runs every rule and collects all failures.
+	ModeAccumulate
+)
+
+// RuleSet composes an ordered list of rules over T and runs them according
+// to Mode.
+type RuleSet[T any] struct {
+	Mode  Mode
+	Rules []Rule[T]
+}
+
+// NewRuleSet builds a RuleSet from the given rules, run in order.
+func NewRuleSet[T any](mode Mode, rules ...Rule[T]) *RuleSet[T] {
+	return &RuleSet[T]{Mode: mode, Rules: rules}
+}
+
+// Validate runs the rules in order against v according to Mode, returning
+// nil when none fail.
+func (rs *RuleSet[T]) Validate(v T) *ValidationError {
+	var verr *ValidationError
+	for _, rule := range rs.Rules {
+		if err := rule.Validate(v); err != nil {
+			if verr == nil {
+				verr = &ValidationError{}
+			}
+			verr.Add(rule.Name(), rule.Code(), rule.Severity(), err)
+			if rs.Mode == ModeShortCircuit {
+				return verr
+			}
+		}
+	}
+	return verr
+}
@@ -0,0 +1,66 @@
+package validate
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// alwaysFailsRule is a test Rule[string] that always fails, recording how
+// many times it was asked to Validate.
+type alwaysFailsRule struct {
+	name  string
+	calls *int
+}
+
+func (r *alwaysFailsRule) Name() string       { return r.name }
+func (r *alwaysFailsRule) Code() string       { return "test." + r.name }
+func (r *alwaysFailsRule) Severity() Severity { return SeverityError }
+
+func (r *alwaysFailsRule) Validate(v string) error {
+	*r.calls++
+	return fmt.Errorf("%s failed for %q", r.name, v)
+}
+
+func TestRuleSet_ModeShortCircuit(t *testing.T) {
+	a := assert.New(t)
+
+	var firstCalls, secondCalls int
+	rs := NewRuleSet[string](
+		ModeShortCircuit,
+		&alwaysFailsRule{name: "first", calls: &firstCalls},
+		&alwaysFailsRule{name: "second", calls: &secondCalls},
+	)
+
+	verr := rs.Validate("subject")
+	a.NotNil(verr)
+	a.Len(verr.Errors, 1, "short circuit should stop at the first failing rule")
+	a.Equal("first", verr.Errors[0].Rule)
+	a.Equal(1, firstCalls)
+	a.Equal(0, secondCalls, "second rule should never run once the first one fails")
+}
+
+func TestRuleSet_ModeAccumulate(t *testing.T) {
+	a := assert.New(t)
+
+	var firstCalls, secondCalls int
+	rs := NewRuleSet[string](
+		ModeAccumulate,
+		&alwaysFailsRule{name: "first", calls: &firstCalls},
+		&alwaysFailsRule{name: "second", calls: &secondCalls},
+	)
+
+	verr := rs.Validate("subject")
+	a.NotNil(verr)
+	a.Len(verr.Errors, 2, "accumulate should collect every failing rule")
+	a.Equal(1, firstCalls)
+	a.Equal(1, secondCalls)
+}
+
+func TestRuleSet_Validate_NoFailures(t *testing.T) {
+	a := assert.New(t)
+
+	rs := NewRuleSet[string](ModeAccumulate)
+	a.Nil(rs.Validate("subject"), "an empty RuleSet should never fail")
+}